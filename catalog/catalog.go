@@ -0,0 +1,183 @@
+// Package catalog lets a service register, in one authoritative place, what each of its error codes
+// means: its severity, whether it's retryable, the HTTP status it maps to, and a text/template message
+// template rendered against the error's attributes. Optional per-locale template bundles let the
+// rendered message be localized based on a context.Context value.
+package catalog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"text/template"
+
+	"go.joshhogle.dev/errorx"
+)
+
+// Definition describes everything a Catalog knows about a single error code.
+type Definition struct {
+	// Code is the error code being described.
+	Code int `json:"code"`
+
+	// Symbol is a short, stable machine-readable name for the code (e.g. "USER_NOT_FOUND").
+	Symbol string `json:"symbol"`
+
+	// Severity is the default severity assigned to errors constructed for this code.
+	Severity errorx.Severity `json:"severity"`
+
+	// Retryable indicates whether a caller can reasonably retry the operation that produced this code.
+	Retryable bool `json:"retryable"`
+
+	// HTTPStatus is the HTTP status code this error should map to, if the code is used over HTTP.
+	HTTPStatus int `json:"http_status"`
+
+	// MessageTemplate is a text/template string rendered against the error's attribute map to produce
+	// Error() text, e.g. "user {{.user_id}} not found".
+	MessageTemplate string `json:"message_template"`
+}
+
+// Error is an error produced by a Catalog. It augments *errorx.BaseError with the Retryable, HTTPStatus
+// and Symbol carried by the Definition it was constructed from.
+type Error struct {
+	*errorx.BaseError
+	def Definition
+}
+
+// Error returns the rendered message template, without the generic "error: " prefix BaseError.Error()
+// would otherwise add around it.
+func (e *Error) Error() string {
+	return e.InternalError().Error()
+}
+
+// Retryable reports whether the operation that produced this error can reasonably be retried.
+func (e *Error) Retryable() bool {
+	return e.def.Retryable
+}
+
+// HTTPStatus returns the HTTP status code this error maps to.
+func (e *Error) HTTPStatus() int {
+	return e.def.HTTPStatus
+}
+
+// Symbol returns the short, stable machine-readable name for this error's code.
+func (e *Error) Symbol() string {
+	return e.def.Symbol
+}
+
+// Catalog is a registry of Definitions, keyed by code, plus any registered locale bundles.
+//
+// Do not create this object directly. Use New() to construct a new object so its values are initialized
+// properly.
+type Catalog struct {
+	mu      sync.RWMutex
+	defs    map[int]Definition
+	locales map[string]map[int]string
+}
+
+// New returns a new, empty Catalog.
+func New() *Catalog {
+	return &Catalog{
+		defs:    map[int]Definition{},
+		locales: map[string]map[int]string{},
+	}
+}
+
+// Register adds def to the catalog.
+//
+// Register panics if def.Code has already been registered, so that a catalog's definitions stay
+// authoritative: every code it describes is described exactly once. Call it from an init() function so
+// duplicate registrations are caught at program startup rather than at request time.
+func (c *Catalog) Register(def Definition) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, exists := c.defs[def.Code]; exists {
+		panic(fmt.Sprintf("catalog: code %d is already registered", def.Code))
+	}
+	c.defs[def.Code] = def
+}
+
+// RegisterLocale adds or extends a bundle of per-code message templates for the given BCP-47 locale tag
+// (e.g. "fr", "pt-BR"), used in place of a Definition's MessageTemplate when Localized is called with a
+// context carrying that locale.
+func (c *Catalog) RegisterLocale(locale string, templates map[int]string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	bundle, ok := c.locales[locale]
+	if !ok {
+		bundle = map[int]string{}
+		c.locales[locale] = bundle
+	}
+	for code, tmpl := range templates {
+		bundle[code] = tmpl
+	}
+}
+
+// New constructs an *Error for code, rendering its message template against attrs.
+//
+// If code has not been registered, the message falls back to a generic "unregistered error code" text
+// and Retryable/HTTPStatus/Symbol are zero-valued.
+func (c *Catalog) New(code int, attrs map[string]any) *Error {
+	return c.newError(context.Background(), code, attrs)
+}
+
+// Localized behaves like New, but additionally selects a locale's message template bundle (registered
+// via RegisterLocale) when ctx carries one via ContextWithLocale.
+func (c *Catalog) Localized(ctx context.Context, code int, attrs map[string]any) *Error {
+	return c.newError(ctx, code, attrs)
+}
+
+// newError implements New and Localized.
+func (c *Catalog) newError(ctx context.Context, code int, attrs map[string]any) *Error {
+	c.mu.RLock()
+	def, known := c.defs[code]
+	tmplText := def.MessageTemplate
+	if locale, ok := LocaleFromContext(ctx); ok {
+		if bundle, ok := c.locales[locale]; ok {
+			if t, ok := bundle[code]; ok {
+				tmplText = t
+			}
+		}
+	}
+	c.mu.RUnlock()
+
+	msg := renderTemplate(tmplText, attrs, code, known)
+	base := errorx.NewBaseError(code, errors.New(msg)).WithSeverity(def.Severity).WithAttrs(attrs)
+	return &Error{BaseError: base, def: def}
+}
+
+// Dump returns the catalog's registered definitions as indented JSON, sorted by code, for use in
+// documentation generation or other tooling.
+func (c *Catalog) Dump() ([]byte, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	defs := make([]Definition, 0, len(c.defs))
+	for _, def := range c.defs {
+		defs = append(defs, def)
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].Code < defs[j].Code })
+	return json.MarshalIndent(defs, "", "  ")
+}
+
+// renderTemplate renders tmplText against attrs. If tmplText is empty, a generic message is produced
+// instead; if tmplText fails to parse or execute, it is returned verbatim rather than failing the
+// error's construction.
+func renderTemplate(tmplText string, attrs map[string]any, code int, known bool) string {
+	if tmplText == "" {
+		if known {
+			return fmt.Sprintf("error code %d", code)
+		}
+		return fmt.Sprintf("unregistered error code %d", code)
+	}
+	t, err := template.New("catalog").Parse(tmplText)
+	if err != nil {
+		return tmplText
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, attrs); err != nil {
+		return tmplText
+	}
+	return buf.String()
+}
@@ -0,0 +1,84 @@
+package catalog_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"go.joshhogle.dev/errorx"
+	"go.joshhogle.dev/errorx/catalog"
+)
+
+func newTestCatalog() *catalog.Catalog {
+	c := catalog.New()
+	c.Register(catalog.Definition{
+		Code:            404,
+		Symbol:          "USER_NOT_FOUND",
+		Severity:        errorx.SeverityWarn,
+		Retryable:       false,
+		HTTPStatus:      404,
+		MessageTemplate: "user {{.user_id}} not found",
+	})
+	return c
+}
+
+func TestCatalogNewRendersTemplate(t *testing.T) {
+	c := newTestCatalog()
+	err := c.New(404, map[string]any{"user_id": "u-123"})
+
+	want := "user u-123 not found"
+	if err.Error() != want {
+		t.Fatalf("expected message %q, got %q", want, err.Error())
+	}
+	if err.Retryable() {
+		t.Fatalf("expected Retryable() to be false")
+	}
+	if err.HTTPStatus() != 404 {
+		t.Fatalf("expected HTTPStatus() 404, got %d", err.HTTPStatus())
+	}
+	if err.Severity() != errorx.SeverityWarn {
+		t.Fatalf("expected SeverityWarn, got %v", err.Severity())
+	}
+}
+
+func TestCatalogRegisterDuplicateCodePanics(t *testing.T) {
+	c := newTestCatalog()
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected duplicate registration to panic")
+		}
+	}()
+	c.Register(catalog.Definition{Code: 404})
+}
+
+func TestCatalogLocalized(t *testing.T) {
+	c := newTestCatalog()
+	c.RegisterLocale("fr", map[int]string{
+		404: "utilisateur {{.user_id}} introuvable",
+	})
+
+	ctx := catalog.ContextWithLocale(context.Background(), "fr")
+	err := c.Localized(ctx, 404, map[string]any{"user_id": "u-123"})
+
+	want := "utilisateur u-123 introuvable"
+	if err.Error() != want {
+		t.Fatalf("expected message %q, got %q", want, err.Error())
+	}
+}
+
+func TestCatalogDumpIncludesRegisteredCode(t *testing.T) {
+	c := newTestCatalog()
+	data, err := c.Dump()
+	if err != nil {
+		t.Fatalf("Dump failed: %v", err)
+	}
+	if !bytes.Contains(data, []byte(`"USER_NOT_FOUND"`)) {
+		t.Fatalf("expected dump to contain the registered symbol, got %s", data)
+	}
+	if !bytes.Contains(data, []byte(`"http_status": 404`)) {
+		t.Fatalf("expected dump to use snake_case field names, got %s", data)
+	}
+	if !bytes.Contains(data, []byte(`"severity": "warn"`)) {
+		t.Fatalf("expected dump to render severity as its name, got %s", data)
+	}
+}
@@ -0,0 +1,18 @@
+package catalog
+
+import "context"
+
+// localeContextKey is the context.Context key under which ContextWithLocale stores a BCP-47 locale tag.
+type localeContextKey struct{}
+
+// ContextWithLocale returns a copy of ctx carrying locale, a BCP-47 tag (e.g. "fr", "pt-BR") used by
+// (*Catalog).Localized to pick a registered locale bundle.
+func ContextWithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeContextKey{}, locale)
+}
+
+// LocaleFromContext returns the locale tag stored by ContextWithLocale, if any.
+func LocaleFromContext(ctx context.Context) (string, bool) {
+	locale, ok := ctx.Value(localeContextKey{}).(string)
+	return locale, ok
+}
@@ -1,11 +1,57 @@
 package errorx
 
 import (
+	"encoding/json"
 	"fmt"
-	"runtime"
+	"log/slog"
+	"sync"
 	"time"
 )
 
+// Severity represents the relative severity of an error for the purposes of logging and reporting.
+type Severity int
+
+const (
+	// SeverityDebug indicates the error is only useful for debugging purposes.
+	SeverityDebug Severity = iota
+
+	// SeverityInfo indicates the error is informational and does not represent a problem on its own.
+	SeverityInfo
+
+	// SeverityWarn indicates the error is a warning about a potential problem.
+	SeverityWarn
+
+	// SeverityError indicates the error represents a problem that should be addressed.
+	SeverityError
+
+	// SeverityFatal indicates the error represents an unrecoverable problem.
+	SeverityFatal
+)
+
+// String returns the string representation of the severity.
+func (s Severity) String() string {
+	switch s {
+	case SeverityDebug:
+		return "debug"
+	case SeverityInfo:
+		return "info"
+	case SeverityWarn:
+		return "warn"
+	case SeverityError:
+		return "error"
+	case SeverityFatal:
+		return "fatal"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalJSON implements json.Marshaler, encoding the severity as its String() name (e.g. "warn") rather
+// than its underlying integer value.
+func (s Severity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
 // Error represents an extension to the standard error interface by adding the ability to include an error code,
 // nested errors, and any attributes associated with the error.
 type Error interface {
@@ -40,14 +86,14 @@ type Error interface {
 // Do not create this object directly. Use NewBaseError() to construct a new object so its values are initialized
 // properly.
 type BaseError struct {
-	errAttrs   map[string]any
-	errCode    int
-	err        error
-	file       string
-	method     string
-	line       int
-	nestedErrs []Error
-	pc         uintptr
+	errAttrs    map[string]any
+	errCode     int
+	errSeverity Severity
+	err         error
+	nestedErrs  []Error
+	pcs         []uintptr
+	frames      []StackFrame
+	framesOnce  sync.Once
 }
 
 // NewBaseError returns a new BaseError object without caller information included.
@@ -56,45 +102,50 @@ func NewBaseError(code int, err error) *BaseError {
 		err = fmt.Errorf("an unknown error occurred (code=%d)", code)
 	}
 	return &BaseError{
-		errAttrs:   map[string]any{},
-		errCode:    code,
-		err:        err,
-		line:       0,
-		nestedErrs: []Error{},
-		pc:         0,
+		errAttrs:    map[string]any{},
+		errCode:     code,
+		errSeverity: SeverityError,
+		err:         err,
+		nestedErrs:  []Error{},
 	}
 }
 
 // NewBaseError returns a new BaseError object with caller information included.
+//
+// skip has the same meaning as the skip parameter of NewBaseErrorWithStack; the captured stack depth is
+// the default set by SetMaxStackDepth (32 frames unless changed).
 func NewBaseErrorWithCaller(code int, err error, skip int) *BaseError {
+	return NewBaseErrorWithStack(code, err, skip+1, 0)
+}
+
+// NewRehydratedBaseError reconstructs a BaseError from explicit field values rather than capturing a live
+// call stack or starting with empty attributes. frame may be nil when no caller information is
+// available.
+//
+// This is intended for reconstructing an error that crossed a process boundary, such as via
+// errorx/wire, where there is no real call stack to capture for the local call site.
+func NewRehydratedBaseError(code int, err error, severity Severity, frame *StackFrame, attrs map[string]any,
+	nested []Error) *BaseError {
 	if err == nil {
 		err = fmt.Errorf("an unknown error occurred (code=%d)", code)
 	}
-	// skip Caller + this function + caller (which will be the actual NewError()... function)
-	pc, file, line, ok := runtime.Caller(skip + 2)
-	if ok {
-		f := runtime.FuncForPC(pc)
-		return &BaseError{
-			errAttrs:   map[string]any{},
-			errCode:    code,
-			err:        err,
-			file:       file,
-			line:       line,
-			method:     f.Name(),
-			nestedErrs: []Error{},
-			pc:         pc,
-		}
+	if attrs == nil {
+		attrs = map[string]any{}
 	}
-	return &BaseError{
-		errAttrs:   map[string]any{},
-		errCode:    code,
-		err:        err,
-		file:       "????",
-		line:       0,
-		method:     "????",
-		nestedErrs: []Error{},
-		pc:         0,
+	if nested == nil {
+		nested = []Error{}
+	}
+	b := &BaseError{
+		errAttrs:    attrs,
+		errCode:     code,
+		errSeverity: severity,
+		err:         err,
+		nestedErrs:  nested,
 	}
+	if frame != nil {
+		b.frames = []StackFrame{*frame}
+	}
+	return b
 }
 
 // Append appends one or more non-nil errors to the end of the list of nested errors associated with this error.
@@ -226,8 +277,13 @@ func (b *BaseError) Error() string {
 }
 
 // File returns the name of the file where the error occurred if caller information is included.
+//
+// This resolves the captured stack trace on first use; see NewBaseErrorWithStack.
 func (b *BaseError) File() string {
-	return b.file
+	if f := b.topFrame(); f != nil {
+		return f.File
+	}
+	return ""
 }
 
 // InternalError returns the standard error associated with the object.
@@ -238,13 +294,90 @@ func (b *BaseError) InternalError() error {
 }
 
 // Line returns the line number where the error occurred if caller information is included.
+//
+// This resolves the captured stack trace on first use; see NewBaseErrorWithStack.
 func (b *BaseError) Line() int {
-	return b.line
+	if f := b.topFrame(); f != nil {
+		return f.Line
+	}
+	return 0
+}
+
+// LogValue implements slog.LogValuer so a BaseError can be logged directly as a structured attribute.
+//
+// The returned value is a group containing the error's code, message, caller information (when present),
+// an "attrs" group holding each of Attrs() as a typed slog.Attr, and a repeated "nested" group for each
+// entry in NestedErrors().
+func (b *BaseError) LogValue() slog.Value {
+	attrs := make([]slog.Attr, 0, 7+len(b.nestedErrs))
+	attrs = append(attrs, slog.Int("code", b.errCode), slog.String("msg", b.err.Error()))
+	if f := b.topFrame(); f != nil {
+		attrs = append(attrs, slog.String("file", f.File), slog.Int("line", f.Line), slog.String("method", f.Function))
+	}
+	if len(b.errAttrs) > 0 {
+		attrAttrs := make([]slog.Attr, 0, len(b.errAttrs))
+		for k, v := range b.errAttrs {
+			attrAttrs = append(attrAttrs, attrToSlogAttr(k, v))
+		}
+		attrs = append(attrs, slog.Attr{Key: "attrs", Value: slog.GroupValue(attrAttrs...)})
+	}
+	for _, n := range b.nestedErrs {
+		if lv, ok := n.(slog.LogValuer); ok {
+			attrs = append(attrs, slog.Any("nested", lv.LogValue()))
+		} else {
+			attrs = append(attrs, slog.String("nested", n.Error()))
+		}
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// attrToSlogAttr converts an attribute value into a typed slog.Attr, mirroring the typed AttrX accessors
+// (AttrString, AttrInt, AttrInt64, AttrUint, AttrUint64, AttrDuration, AttrTime) plus bool and []byte.
+// Values of any other type fall back to slog.Any.
+func attrToSlogAttr(key string, v any) slog.Attr {
+	switch val := v.(type) {
+	case string:
+		return slog.String(key, val)
+	case int:
+		return slog.Int(key, val)
+	case int64:
+		return slog.Int64(key, val)
+	case uint:
+		return slog.Uint64(key, uint64(val))
+	case uint64:
+		return slog.Uint64(key, val)
+	case bool:
+		return slog.Bool(key, val)
+	case []byte:
+		return slog.String(key, string(val))
+	case time.Duration:
+		return slog.Duration(key, val)
+	case time.Time:
+		return slog.Time(key, val)
+	default:
+		return slog.Any(key, val)
+	}
+}
+
+// Is reports whether target matches b, for use by errors.Is.
+//
+// If target is an Error with a non-zero Code(), the two match when their codes are equal. Otherwise, b
+// matches target when its internal error is target.
+func (b *BaseError) Is(target error) bool {
+	if t, ok := target.(Error); ok && t.Code() != 0 {
+		return b.errCode == t.Code()
+	}
+	return b.err == target
 }
 
 // Method returns the name of the function where the error occurred if caller information is included.
+//
+// This resolves the captured stack trace on first use; see NewBaseErrorWithStack.
 func (b *BaseError) Method() string {
-	return b.method
+	if f := b.topFrame(); f != nil {
+		return f.Function
+	}
+	return ""
 }
 
 // NestedErrors returns the list of errors that were generated by a call to another function.
@@ -254,6 +387,12 @@ func (b *BaseError) NestedErrors() []Error {
 	return b.nestedErrs
 }
 
+// Severity returns the severity associated with the error. Unless changed via WithSeverity, a newly
+// constructed error has a severity of SeverityError.
+func (b *BaseError) Severity() Severity {
+	return b.errSeverity
+}
+
 // WithAttr adds the given key/value pair to the list of attributes associated with this error and
 // returns itself
 func (b *BaseError) WithAttr(attrKey string, attrValue any) *BaseError {
@@ -269,3 +408,20 @@ func (b *BaseError) WithAttrs(attrs map[string]any) *BaseError {
 	}
 	return b
 }
+
+// WithSeverity sets the severity associated with the error and returns itself.
+func (b *BaseError) WithSeverity(severity Severity) *BaseError {
+	b.errSeverity = severity
+	return b
+}
+
+// Unwrap returns the internal error and any nested errors so the errors package (Go 1.20+) can traverse
+// the whole tree via errors.Is and errors.As.
+func (b *BaseError) Unwrap() []error {
+	errs := make([]error, 0, len(b.nestedErrs)+1)
+	errs = append(errs, b.err)
+	for _, n := range b.nestedErrs {
+		errs = append(errs, n)
+	}
+	return errs
+}
@@ -0,0 +1,254 @@
+package errorx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// wireAttr is the typed wire representation of a single attribute value. It mirrors the typed AttrX
+// accessors (AttrString, AttrInt, AttrInt64, AttrUint, AttrUint64, AttrDuration, AttrTime) plus bool and
+// []byte, so unmarshaling reconstructs the original Go type instead of collapsing everything to the
+// types encoding/json would otherwise infer.
+//
+// Duration is encoded as an ISO-8601 duration string (e.g. "PT1H30M") rather than a bare integer, so
+// non-Go consumers of this wire schema don't have to guess a unit for a raw number.
+type wireAttr struct {
+	Type     string     `json:"type"`
+	String   string     `json:"string,omitempty"`
+	Int      int        `json:"int,omitempty"`
+	Int64    int64      `json:"int64,omitempty"`
+	Uint     uint       `json:"uint,omitempty"`
+	Uint64   uint64     `json:"uint64,omitempty"`
+	Duration string     `json:"duration,omitempty"`
+	Time     *time.Time `json:"time,omitempty"`
+	Bool     bool       `json:"bool,omitempty"`
+	Bytes    []byte     `json:"bytes,omitempty"`
+}
+
+// wireError is the stable JSON representation of an Error: {code, message, attrs, file, line, method,
+// nested}. The errorx.v1.Error protobuf message in errorx/wire has the same top-level fields.
+type wireError struct {
+	Code    int                 `json:"code"`
+	Message string              `json:"message"`
+	Attrs   map[string]wireAttr `json:"attrs,omitempty"`
+	File    string              `json:"file,omitempty"`
+	Line    int                 `json:"line,omitempty"`
+	Method  string              `json:"method,omitempty"`
+	Nested  []wireError         `json:"nested,omitempty"`
+}
+
+// encodeAttr converts an attribute value into its typed wire representation.
+func encodeAttr(v any) (wireAttr, error) {
+	switch val := v.(type) {
+	case string:
+		return wireAttr{Type: "string", String: val}, nil
+	case int:
+		return wireAttr{Type: "int", Int: val}, nil
+	case int64:
+		return wireAttr{Type: "int64", Int64: val}, nil
+	case uint:
+		return wireAttr{Type: "uint", Uint: val}, nil
+	case uint64:
+		return wireAttr{Type: "uint64", Uint64: val}, nil
+	case time.Duration:
+		return wireAttr{Type: "duration", Duration: encodeISODuration(val)}, nil
+	case time.Time:
+		t := val
+		return wireAttr{Type: "time", Time: &t}, nil
+	case bool:
+		return wireAttr{Type: "bool", Bool: val}, nil
+	case []byte:
+		return wireAttr{Type: "bytes", Bytes: val}, nil
+	default:
+		return wireAttr{}, fmt.Errorf("errorx: attribute of type %T cannot be marshaled", v)
+	}
+}
+
+// decodeAttr reverses encodeAttr.
+func decodeAttr(w wireAttr) (any, error) {
+	switch w.Type {
+	case "string":
+		return w.String, nil
+	case "int":
+		return w.Int, nil
+	case "int64":
+		return w.Int64, nil
+	case "uint":
+		return w.Uint, nil
+	case "uint64":
+		return w.Uint64, nil
+	case "duration":
+		return decodeISODuration(w.Duration)
+	case "time":
+		if w.Time == nil {
+			return time.Time{}, nil
+		}
+		return *w.Time, nil
+	case "bool":
+		return w.Bool, nil
+	case "bytes":
+		return w.Bytes, nil
+	default:
+		return nil, fmt.Errorf("errorx: attribute type %q cannot be unmarshaled", w.Type)
+	}
+}
+
+// isoDurationRE matches the ISO-8601 duration subset produced by encodeISODuration: an optional sign, the
+// "PT" designator, and any combination of hour/minute/second components, the last of which may carry a
+// fractional part.
+var isoDurationRE = regexp.MustCompile(`^(-)?PT(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?$`)
+
+// encodeISODuration renders d as an ISO-8601 duration string, e.g. "PT1H30M" or "PT0.5S".
+func encodeISODuration(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+	hours := d / time.Hour
+	d -= hours * time.Hour
+	minutes := d / time.Minute
+	d -= minutes * time.Minute
+	seconds := d.Seconds()
+
+	var b strings.Builder
+	if hours > 0 {
+		fmt.Fprintf(&b, "%dH", hours)
+	}
+	if minutes > 0 {
+		fmt.Fprintf(&b, "%dM", minutes)
+	}
+	if seconds != 0 || b.Len() == 0 {
+		if seconds == math.Trunc(seconds) {
+			fmt.Fprintf(&b, "%dS", int64(seconds))
+		} else {
+			b.WriteString(strconv.FormatFloat(seconds, 'f', -1, 64))
+			b.WriteByte('S')
+		}
+	}
+	return sign + "PT" + b.String()
+}
+
+// decodeISODuration reverses encodeISODuration.
+func decodeISODuration(s string) (time.Duration, error) {
+	m := isoDurationRE.FindStringSubmatch(s)
+	if m == nil {
+		return 0, fmt.Errorf("errorx: %q is not a valid ISO-8601 duration", s)
+	}
+	var total time.Duration
+	if m[2] != "" {
+		hours, _ := strconv.ParseInt(m[2], 10, 64)
+		total += time.Duration(hours) * time.Hour
+	}
+	if m[3] != "" {
+		minutes, _ := strconv.ParseInt(m[3], 10, 64)
+		total += time.Duration(minutes) * time.Minute
+	}
+	if m[4] != "" {
+		seconds, _ := strconv.ParseFloat(m[4], 64)
+		total += time.Duration(seconds * float64(time.Second))
+	}
+	if m[1] == "-" {
+		total = -total
+	}
+	return total, nil
+}
+
+// toWireError converts any Error into its wire representation, recursing into NestedErrors().
+//
+// Message carries InternalError()'s raw text rather than Error()'s formatted output: Error() on a
+// reconstructed BaseError already re-derives its formatted form from the internal error, so wiring
+// through the formatted string would compound "error: " prefixes on every hop.
+func toWireError(e Error) (wireError, error) {
+	w := wireError{
+		Code:    e.Code(),
+		Message: e.InternalError().Error(),
+		File:    e.File(),
+		Line:    e.Line(),
+		Method:  e.Method(),
+	}
+	if attrs := e.Attrs(); len(attrs) > 0 {
+		w.Attrs = make(map[string]wireAttr, len(attrs))
+		for k, v := range attrs {
+			wa, err := encodeAttr(v)
+			if err != nil {
+				return wireError{}, fmt.Errorf("attribute %q: %w", k, err)
+			}
+			w.Attrs[k] = wa
+		}
+	}
+	for _, n := range e.NestedErrors() {
+		nw, err := toWireError(n)
+		if err != nil {
+			return wireError{}, err
+		}
+		w.Nested = append(w.Nested, nw)
+	}
+	return w, nil
+}
+
+// fromWireError reverses toWireError, reconstructing a BaseError tree via NewRehydratedBaseError.
+func fromWireError(w wireError) (*BaseError, error) {
+	attrs := make(map[string]any, len(w.Attrs))
+	for k, wa := range w.Attrs {
+		v, err := decodeAttr(wa)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %q: %w", k, err)
+		}
+		attrs[k] = v
+	}
+	nested := make([]Error, 0, len(w.Nested))
+	for _, nw := range w.Nested {
+		n, err := fromWireError(nw)
+		if err != nil {
+			return nil, err
+		}
+		nested = append(nested, n)
+	}
+	var frame *StackFrame
+	if w.File != "" || w.Method != "" || w.Line != 0 {
+		frame = &StackFrame{File: w.File, Line: w.Line, Function: w.Method}
+	}
+	return NewRehydratedBaseError(w.Code, errors.New(w.Message), SeverityError, frame, attrs, nested), nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the error's code, message, attributes, caller
+// information and nested errors using the stable schema shared with errorx/wire.
+func (b *BaseError) MarshalJSON() ([]byte, error) {
+	w, err := toWireError(b)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(w)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, reconstructing a BaseError from the stable schema shared
+// with errorx/wire.
+//
+// The resulting error's InternalError() is a plain error wrapping the encoded message, and its severity
+// is SeverityError; neither the original internal error type nor severity survive the round trip since
+// the schema does not carry them.
+func (b *BaseError) UnmarshalJSON(data []byte) error {
+	var w wireError
+	if err := json.Unmarshal(data, &w); err != nil {
+		return err
+	}
+	rebuilt, err := fromWireError(w)
+	if err != nil {
+		return err
+	}
+	b.errAttrs = rebuilt.errAttrs
+	b.errCode = rebuilt.errCode
+	b.errSeverity = rebuilt.errSeverity
+	b.err = rebuilt.err
+	b.nestedErrs = rebuilt.nestedErrs
+	b.pcs = rebuilt.pcs
+	b.frames = rebuilt.frames
+	return nil
+}
@@ -0,0 +1,104 @@
+package errorx_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"go.joshhogle.dev/errorx"
+)
+
+func TestBaseErrorJSONRoundTrip(t *testing.T) {
+	e1 := errorx.NewBaseError(100, errors.New("inner"))
+	e2 := errorx.NewBaseError(101, errors.New("outer"))
+	e2.Append(e1)
+	e2.WithAttrs(map[string]any{
+		"str":      "value",
+		"count":    42,
+		"big":      int64(9999999999),
+		"uns":      uint(7),
+		"uns64":    uint64(8),
+		"flag":     true,
+		"interval": 5 * time.Second,
+		"when":     time.Now().UTC().Truncate(time.Second),
+	})
+
+	data, err := json.Marshal(e2)
+	if err != nil {
+		t.Fatalf("MarshalJSON failed: %v", err)
+	}
+
+	var out errorx.BaseError
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("UnmarshalJSON failed: %v", err)
+	}
+
+	if out.Code() != e2.Code() {
+		t.Fatalf("expected code %d, got %d", e2.Code(), out.Code())
+	}
+	if out.Error() != e2.Error() {
+		t.Fatalf("expected Error() %q, got %q", e2.Error(), out.Error())
+	}
+	if len(out.NestedErrors()) != 1 {
+		t.Fatalf("expected 1 nested error, got %d", len(out.NestedErrors()))
+	}
+	if out.NestedErrors()[0].Code() != e1.Code() {
+		t.Fatalf("expected nested code %d, got %d", e1.Code(), out.NestedErrors()[0].Code())
+	}
+
+	if v, err := out.AttrInt("count"); err != nil || v != 42 {
+		t.Fatalf("expected count=42, got %v (err=%v)", v, err)
+	}
+	if v, err := out.AttrDuration("interval"); err != nil || v != 5*time.Second {
+		t.Fatalf("expected interval=5s, got %v (err=%v)", v, err)
+	}
+}
+
+func TestDurationAttrEncodesAsISO8601(t *testing.T) {
+	e := errorx.NewBaseError(100, errors.New("slow"))
+	e.WithAttr("interval", 90*time.Minute)
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		t.Fatalf("MarshalJSON failed: %v", err)
+	}
+
+	var raw struct {
+		Attrs map[string]struct {
+			Duration string `json:"duration"`
+		} `json:"attrs"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if got, want := raw.Attrs["interval"].Duration, "PT1H30M"; got != want {
+		t.Fatalf("expected duration %q, got %q", want, got)
+	}
+
+	var out errorx.BaseError
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("UnmarshalJSON failed: %v", err)
+	}
+	if v, err := out.AttrDuration("interval"); err != nil || v != 90*time.Minute {
+		t.Fatalf("expected interval=90m, got %v (err=%v)", v, err)
+	}
+}
+
+func TestTinyDurationAttrRoundTrips(t *testing.T) {
+	e := errorx.NewBaseError(100, errors.New("slow"))
+	e.WithAttr("interval", 100*time.Nanosecond)
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		t.Fatalf("MarshalJSON failed: %v", err)
+	}
+
+	var out errorx.BaseError
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("UnmarshalJSON failed: %v", err)
+	}
+	if v, err := out.AttrDuration("interval"); err != nil || v != 100*time.Nanosecond {
+		t.Fatalf("expected interval=100ns, got %v (err=%v)", v, err)
+	}
+}
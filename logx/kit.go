@@ -0,0 +1,55 @@
+package logx
+
+import (
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+
+	"go.joshhogle.dev/errorx"
+)
+
+// Log emits err to a go-kit/log logger, recursing into NestedErrors() so each level of the tree becomes
+// its own structured record, mirroring LogTo's behavior for slog. Every nested record carries a
+// "parent_code" keyval set to its immediate parent's Code() so the parent -> child linkage survives the
+// flattening into separate records.
+func Log(logger log.Logger, err errorx.Error) error {
+	if logErr := logOne(logger, err, 0, false); logErr != nil {
+		return logErr
+	}
+	return logNestedKit(logger, err.NestedErrors(), err.Code())
+}
+
+// logNestedKit emits one record per entry in errs, tagged with parentCode, then recurses into each
+// entry's own nested errors.
+func logNestedKit(logger log.Logger, errs []errorx.Error, parentCode int) error {
+	for _, err := range errs {
+		if logErr := logOne(logger, err, parentCode, true); logErr != nil {
+			return logErr
+		}
+		if logErr := logNestedKit(logger, err.NestedErrors(), err.Code()); logErr != nil {
+			return logErr
+		}
+	}
+	return nil
+}
+
+// logOne emits a single record for err, choosing level.Error, level.Warn or level.Info based on err's
+// Severity(). The code and message are logged as the "code" and "msg" keys, each of err's Attrs() is
+// logged as an "attr_"-prefixed key, and, when hasParent is true, parentCode is logged as "parent_code".
+func logOne(logger log.Logger, err errorx.Error, parentCode int, hasParent bool) error {
+	leveled := level.Error
+	switch severityOf(err) {
+	case errorx.SeverityDebug, errorx.SeverityInfo:
+		leveled = level.Info
+	case errorx.SeverityWarn:
+		leveled = level.Warn
+	}
+	keyvals := make([]any, 0, 6+2*len(err.Attrs()))
+	keyvals = append(keyvals, "code", err.Code(), "msg", err.Error())
+	for k, v := range err.Attrs() {
+		keyvals = append(keyvals, "attr_"+k, v)
+	}
+	if hasParent {
+		keyvals = append(keyvals, "parent_code", parentCode)
+	}
+	return leveled(logger).Log(keyvals...)
+}
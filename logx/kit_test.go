@@ -0,0 +1,40 @@
+package logx_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/log"
+
+	"go.joshhogle.dev/errorx"
+	"go.joshhogle.dev/errorx/logx"
+)
+
+func TestLog(t *testing.T) {
+	e1 := errorx.NewBaseError(100, errors.New("inner failure"))
+	e2 := errorx.NewBaseError(101, errors.New("outer failure"))
+	e2.Append(e1)
+	e2.WithAttr("user_id", "u-123")
+
+	buf := bytes.NewBuffer(nil)
+	logger := log.NewLogfmtLogger(buf)
+	if err := logx.Log(logger, e2); err != nil {
+		t.Fatalf("Log failed: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 log records, got %d: %q", len(lines), buf.String())
+	}
+	if !strings.Contains(lines[0], "attr_user_id=u-123") {
+		t.Fatalf("expected top-level record to contain attr_user_id=u-123, got %q", lines[0])
+	}
+	if !strings.Contains(lines[1], "parent_code=101") {
+		t.Fatalf("expected nested record to contain parent_code=101, got %q", lines[1])
+	}
+	if !strings.Contains(lines[1], "code=100") {
+		t.Fatalf("expected nested record to contain code=100, got %q", lines[1])
+	}
+}
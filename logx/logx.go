@@ -0,0 +1,66 @@
+// Package logx bridges errorx errors into the structured-logging ecosystem, with adapters for
+// log/slog, go-kit/log and zap so callers don't have to hand-flatten an error's attrs.
+package logx
+
+import (
+	"context"
+	"log/slog"
+
+	"go.joshhogle.dev/errorx"
+)
+
+// severitier is implemented by errors (such as *errorx.BaseError) that carry a Severity.
+type severitier interface {
+	Severity() errorx.Severity
+}
+
+// severityOf returns err's severity if it implements severitier, or errorx.SeverityError otherwise.
+func severityOf(err errorx.Error) errorx.Severity {
+	if s, ok := err.(severitier); ok {
+		return s.Severity()
+	}
+	return errorx.SeverityError
+}
+
+// slogLevel maps an errorx.Severity to the nearest slog.Level. slog has no "fatal" level, so
+// SeverityFatal is clamped to slog.LevelError.
+func slogLevel(severity errorx.Severity) slog.Level {
+	switch severity {
+	case errorx.SeverityDebug:
+		return slog.LevelDebug
+	case errorx.SeverityInfo:
+		return slog.LevelInfo
+	case errorx.SeverityWarn:
+		return slog.LevelWarn
+	default:
+		return slog.LevelError
+	}
+}
+
+// SlogAttr converts err into a slog.Attr named "error", using its LogValue() representation when err
+// implements slog.LogValuer and falling back to its Error() string otherwise.
+func SlogAttr(err errorx.Error) slog.Attr {
+	if lv, ok := err.(slog.LogValuer); ok {
+		return slog.Any("error", lv.LogValue())
+	}
+	return slog.String("error", err.Error())
+}
+
+// LogTo recursively walks err's NestedErrors() and emits one structured record per level to logger, at
+// the level matching each error's Severity(). Every nested record carries a "parent_code" attribute set
+// to its immediate parent's Code() so the parent -> child linkage survives the flattening into separate
+// records.
+func LogTo(logger *slog.Logger, err errorx.Error) {
+	logger.LogAttrs(context.Background(), slogLevel(severityOf(err)), err.Error(), SlogAttr(err))
+	logNested(logger, err.NestedErrors(), err.Code())
+}
+
+// logNested emits one record per entry in errs, tagged with parentCode, then recurses into each entry's
+// own nested errors.
+func logNested(logger *slog.Logger, errs []errorx.Error, parentCode int) {
+	for _, err := range errs {
+		logger.LogAttrs(context.Background(), slogLevel(severityOf(err)), err.Error(),
+			SlogAttr(err), slog.Int("parent_code", parentCode))
+		logNested(logger, err.NestedErrors(), err.Code())
+	}
+}
@@ -0,0 +1,53 @@
+package logx_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"go.joshhogle.dev/errorx"
+	"go.joshhogle.dev/errorx/logx"
+)
+
+func TestLogTo(t *testing.T) {
+	e1 := errorx.NewBaseError(100, errors.New("inner failure"))
+	e2 := errorx.NewBaseError(101, errors.New("outer failure"))
+	e2.Append(e1)
+	e2.WithAttr("user_id", "u-123")
+
+	buf := bytes.NewBuffer(nil)
+	logger := slog.New(slog.NewJSONHandler(buf, nil))
+	logx.LogTo(logger, e2)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 log records, got %d", len(lines))
+	}
+
+	var top map[string]any
+	if err := json.Unmarshal([]byte(lines[0]), &top); err != nil {
+		t.Fatalf("failed to unmarshal top-level record: %v", err)
+	}
+	errGroup, ok := top["error"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected an \"error\" group in %v", top)
+	}
+	attrsGroup, ok := errGroup["attrs"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected an \"attrs\" group in %v", errGroup)
+	}
+	if attrsGroup["user_id"] != "u-123" {
+		t.Fatalf("expected attrs.user_id=%q, got %v", "u-123", attrsGroup["user_id"])
+	}
+
+	var nested map[string]any
+	if err := json.Unmarshal([]byte(lines[1]), &nested); err != nil {
+		t.Fatalf("failed to unmarshal nested record: %v", err)
+	}
+	if code, ok := nested["parent_code"].(float64); !ok || int(code) != e2.Code() {
+		t.Fatalf("expected parent_code=%d, got %v", e2.Code(), nested["parent_code"])
+	}
+}
@@ -0,0 +1,88 @@
+package logx
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"go.joshhogle.dev/errorx"
+)
+
+// ObjectMarshaler adapts an errorx.Error to zapcore.ObjectMarshaler so it can be logged as a structured
+// object via zap.Object.
+type ObjectMarshaler struct {
+	Err errorx.Error
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler.
+func (o ObjectMarshaler) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt("code", o.Err.Code())
+	enc.AddString("msg", o.Err.Error())
+	if file := o.Err.File(); file != "" {
+		enc.AddString("file", file)
+	}
+	if line := o.Err.Line(); line != 0 {
+		enc.AddInt("line", line)
+	}
+	if method := o.Err.Method(); method != "" {
+		enc.AddString("method", method)
+	}
+	if attrs := o.Err.Attrs(); len(attrs) > 0 {
+		if err := enc.AddObject("attrs", attrsMarshaler(attrs)); err != nil {
+			return err
+		}
+	}
+	nested := o.Err.NestedErrors()
+	if len(nested) == 0 {
+		return nil
+	}
+	return enc.AddArray("nested", zapcore.ArrayMarshalerFunc(func(aenc zapcore.ArrayEncoder) error {
+		for _, n := range nested {
+			if err := aenc.AppendObject(ObjectMarshaler{Err: n}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+// attrsMarshaler adapts an attribute map to zapcore.ObjectMarshaler, emitting each value via the
+// zapcore.ObjectEncoder method matching its type, mirroring the typed AttrX accessors on BaseError.
+type attrsMarshaler map[string]any
+
+// MarshalLogObject implements zapcore.ObjectMarshaler.
+func (a attrsMarshaler) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for k, v := range a {
+		switch val := v.(type) {
+		case string:
+			enc.AddString(k, val)
+		case int:
+			enc.AddInt(k, val)
+		case int64:
+			enc.AddInt64(k, val)
+		case uint:
+			enc.AddUint(k, val)
+		case uint64:
+			enc.AddUint64(k, val)
+		case bool:
+			enc.AddBool(k, val)
+		case []byte:
+			enc.AddBinary(k, val)
+		case time.Duration:
+			enc.AddDuration(k, val)
+		case time.Time:
+			enc.AddTime(k, val)
+		default:
+			if err := enc.AddReflected(k, val); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Field returns a zap.Field named "error" wrapping err as a zapcore.ObjectMarshaler.
+func Field(err errorx.Error) zap.Field {
+	return zap.Object("error", ObjectMarshaler{Err: err})
+}
@@ -0,0 +1,45 @@
+package logx_test
+
+import (
+	"errors"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"go.joshhogle.dev/errorx"
+	"go.joshhogle.dev/errorx/logx"
+)
+
+func TestField(t *testing.T) {
+	e1 := errorx.NewBaseError(100, errors.New("inner failure"))
+	e2 := errorx.NewBaseError(101, errors.New("outer failure"))
+	e2.Append(e1)
+	e2.WithAttr("user_id", "u-123")
+
+	core, logs := observer.New(zap.DebugLevel)
+	zap.New(core).Info("request failed", logx.Field(e2))
+
+	entries := logs.All()
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 log entry, got %d", len(entries))
+	}
+
+	fields := entries[0].ContextMap()
+	errObj, ok := fields["error"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected an \"error\" object in %v", fields)
+	}
+	attrs, ok := errObj["attrs"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected an \"attrs\" object in %v", errObj)
+	}
+	if attrs["user_id"] != "u-123" {
+		t.Fatalf("expected attrs.user_id=%q, got %v", "u-123", attrs["user_id"])
+	}
+
+	nested, ok := errObj["nested"].([]any)
+	if !ok || len(nested) != 1 {
+		t.Fatalf("expected 1 nested entry, got %v", errObj["nested"])
+	}
+}
@@ -0,0 +1,126 @@
+package errorx
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// defaultMaxStackDepth is the number of stack frames captured when NewBaseErrorWithCaller or
+// NewBaseErrorWithStack (with a depth of 0) is used to construct an error.
+var defaultMaxStackDepth = 32
+
+// SetMaxStackDepth changes the default number of stack frames captured by NewBaseErrorWithCaller and by
+// NewBaseErrorWithStack calls that pass a depth of 0. It has no effect on errors already constructed.
+func SetMaxStackDepth(depth int) {
+	if depth > 0 {
+		defaultMaxStackDepth = depth
+	}
+}
+
+// StackFrame describes a single frame of a captured call stack.
+type StackFrame struct {
+	// PC is the program counter for the frame.
+	PC uintptr
+
+	// File is the name of the file containing the frame's function.
+	File string
+
+	// Line is the line number within File.
+	Line int
+
+	// Function is the fully-qualified name of the frame's function.
+	Function string
+}
+
+// NewBaseErrorWithStack returns a new BaseError object with a full call stack captured via
+// runtime.Callers, starting skip frames above the caller of this function.
+//
+// depth controls how many frames are captured; a depth of 0 uses the default set by SetMaxStackDepth
+// (32 unless changed). Symbolization of the captured program counters into file/line/function
+// information is deferred until the first call to StackTrace(), Frames(), File(), Method() or Line(), so
+// construction stays cheap even when the stack is never inspected.
+func NewBaseErrorWithStack(code int, err error, skip int, depth int) *BaseError {
+	if err == nil {
+		err = fmt.Errorf("an unknown error occurred (code=%d)", code)
+	}
+	if depth <= 0 {
+		depth = defaultMaxStackDepth
+	}
+	pcs := make([]uintptr, depth)
+	// skip runtime.Callers itself (0=Callers, 1=this function), landing on our direct caller.
+	n := runtime.Callers(skip+2, pcs)
+	return &BaseError{
+		errAttrs:    map[string]any{},
+		errCode:     code,
+		errSeverity: SeverityError,
+		err:         err,
+		nestedErrs:  []Error{},
+		pcs:         pcs[:n],
+	}
+}
+
+// resolveFrames symbolizes the captured program counters into frames exactly once.
+func (b *BaseError) resolveFrames() {
+	b.framesOnce.Do(func() {
+		if len(b.pcs) == 0 {
+			return
+		}
+		frames := runtime.CallersFrames(b.pcs)
+		for {
+			frame, more := frames.Next()
+			b.frames = append(b.frames, StackFrame{
+				PC:       frame.PC,
+				File:     frame.File,
+				Line:     frame.Line,
+				Function: frame.Function,
+			})
+			if !more {
+				break
+			}
+		}
+	})
+}
+
+// topFrame returns the first (innermost) captured frame, resolving the stack on first use, or nil if no
+// caller information was captured.
+func (b *BaseError) topFrame() *StackFrame {
+	b.resolveFrames()
+	if len(b.frames) == 0 {
+		return nil
+	}
+	return &b.frames[0]
+}
+
+// Frames returns the fully symbolized call stack captured when the error was constructed, innermost
+// frame first. It is empty if the error was created without caller information (e.g. via NewBaseError).
+func (b *BaseError) Frames() []StackFrame {
+	b.resolveFrames()
+	return b.frames
+}
+
+// StackTrace returns the captured call stack formatted in github.com/pkg/errors style, one frame per
+// function/file:line pair.
+func (b *BaseError) StackTrace() string {
+	b.resolveFrames()
+	out := ""
+	for _, f := range b.frames {
+		out += fmt.Sprintf("%s\n\t%s:%d\n", f.Function, f.File, f.Line)
+	}
+	return out
+}
+
+// Format implements fmt.Formatter. The "%+v" verb prints the error message followed by its captured
+// stack trace in github.com/pkg/errors style; all other verbs behave as if b were printed via its
+// Error() string.
+func (b *BaseError) Format(s fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && s.Flag('+'):
+		fmt.Fprint(s, b.Error())
+		if trace := b.StackTrace(); trace != "" {
+			fmt.Fprint(s, "\n")
+			fmt.Fprint(s, trace)
+		}
+	default:
+		fmt.Fprint(s, b.Error())
+	}
+}
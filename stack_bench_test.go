@@ -0,0 +1,29 @@
+package errorx_test
+
+import (
+	"errors"
+	"testing"
+
+	"go.joshhogle.dev/errorx"
+)
+
+// BenchmarkNewBaseErrorWithStack measures the cost of capturing a stack without ever symbolizing it,
+// i.e. the lazy-resolution path that construction alone pays for.
+func BenchmarkNewBaseErrorWithStack(b *testing.B) {
+	err := errors.New("boom")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = errorx.NewBaseErrorWithStack(1, err, 0, 0)
+	}
+}
+
+// BenchmarkNewBaseErrorWithStackAndResolve measures the cost of capturing a stack and immediately
+// resolving it via Frames(), i.e. what an eager design would pay on every construction.
+func BenchmarkNewBaseErrorWithStackAndResolve(b *testing.B) {
+	err := errors.New("boom")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		e := errorx.NewBaseErrorWithStack(1, err, 0, 0)
+		_ = e.Frames()
+	}
+}
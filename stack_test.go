@@ -0,0 +1,62 @@
+package errorx_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"go.joshhogle.dev/errorx"
+)
+
+func TestNewBaseErrorWithCallerCapturesTopFrame(t *testing.T) {
+	e := errorx.NewBaseErrorWithCaller(1, errors.New("boom"), 0)
+	if e.Method() == "" {
+		t.Fatalf("expected Method() to be populated")
+	}
+	if !strings.Contains(e.Method(), "TestNewBaseErrorWithCallerCapturesTopFrame") {
+		t.Fatalf("expected top frame to be this test function, got %q", e.Method())
+	}
+	if e.Line() == 0 {
+		t.Fatalf("expected Line() to be populated")
+	}
+}
+
+func TestNewBaseErrorWithStackCapturesMultipleFrames(t *testing.T) {
+	e := helperWithStack()
+	frames := e.Frames()
+	if len(frames) < 2 {
+		t.Fatalf("expected at least 2 captured frames, got %d", len(frames))
+	}
+	if !strings.Contains(frames[0].Function, "helperWithStack") {
+		t.Fatalf("expected innermost frame to be helperWithStack, got %q", frames[0].Function)
+	}
+	if !strings.Contains(frames[1].Function, "TestNewBaseErrorWithStackCapturesMultipleFrames") {
+		t.Fatalf("expected second frame to be this test, got %q", frames[1].Function)
+	}
+}
+
+func helperWithStack() *errorx.BaseError {
+	return errorx.NewBaseErrorWithStack(1, errors.New("boom"), 0, 4)
+}
+
+func TestBaseErrorNoCallerInfo(t *testing.T) {
+	e := errorx.NewBaseError(1, errors.New("boom"))
+	if len(e.Frames()) != 0 {
+		t.Fatalf("expected no frames without caller info, got %d", len(e.Frames()))
+	}
+	if e.File() != "" || e.Method() != "" || e.Line() != 0 {
+		t.Fatalf("expected empty caller info, got file=%q method=%q line=%d", e.File(), e.Method(), e.Line())
+	}
+}
+
+func TestBaseErrorFormatPlusV(t *testing.T) {
+	e := errorx.NewBaseErrorWithCaller(1, errors.New("boom"), 0)
+	out := fmt.Sprintf("%+v", e)
+	if !strings.Contains(out, e.Error()) {
+		t.Fatalf("expected %%+v output to contain the error message, got %q", out)
+	}
+	if !strings.Contains(out, "stack_test.go") {
+		t.Fatalf("expected %%+v output to contain the stack trace, got %q", out)
+	}
+}
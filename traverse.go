@@ -0,0 +1,44 @@
+package errorx
+
+import "errors"
+
+// Is reports whether any error in err's tree matches target. It is a thin wrapper around errors.Is
+// provided so callers don't need to import the standard errors package just to search an errorx tree.
+func Is(err, target error) bool {
+	return errors.Is(err, target)
+}
+
+// As finds the first error in err's tree that matches target and, if one is found, sets target to that
+// error and returns true. It is a thin wrapper around errors.As.
+func As(err error, target any) bool {
+	return errors.As(err, target)
+}
+
+// Code returns the Code() of the first Error in err's tree, along with true. If no Error is found in the
+// tree, it returns (0, false).
+func Code(err error) (int, bool) {
+	var e Error
+	if errors.As(err, &e) {
+		return e.Code(), true
+	}
+	return 0, false
+}
+
+// Walk calls fn for err and, as long as fn returns true, recursively for each of its NestedErrors(), in
+// order. Walk stops as soon as fn returns false or err does not implement Error.
+func Walk(err error, fn func(Error) bool) {
+	var walk func(error) bool
+	walk = func(err error) bool {
+		e, ok := err.(Error)
+		if !ok || !fn(e) {
+			return false
+		}
+		for _, n := range e.NestedErrors() {
+			if !walk(n) {
+				return false
+			}
+		}
+		return true
+	}
+	walk(err)
+}
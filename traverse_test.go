@@ -0,0 +1,69 @@
+package errorx_test
+
+import (
+	"errors"
+	"testing"
+
+	"go.joshhogle.dev/errorx"
+)
+
+func TestErrorsIsDescendsIntoNestedErrors(t *testing.T) {
+	e1 := errorx.NewBaseError(100, errors.New("e1"))
+	e2 := errorx.NewBaseError(101, errors.New("e2"))
+	e2.Append(e1)
+	e3 := errorx.NewBaseError(102, errors.New("e3"))
+	e3.Append(e2)
+
+	if !errors.Is(e3, e1) {
+		t.Fatalf("expected errors.Is(e3, e1) to be true; e1 is nested two levels deep in e3")
+	}
+}
+
+func TestErrorsAsFindsNestedError(t *testing.T) {
+	e1 := NewGenericError(100, errors.New("e1"))
+	e2 := errorx.NewBaseError(101, errors.New("e2"))
+	e2.Append(e1)
+
+	var target *GenericError
+	if !errors.As(e2, &target) {
+		t.Fatalf("expected errors.As(e2, &target) to succeed")
+	}
+	if target.Code() != e1.Code() {
+		t.Fatalf("expected errors.As to find nested error with code %d, got %d", e1.Code(), target.Code())
+	}
+}
+
+func TestCode(t *testing.T) {
+	e1 := errorx.NewBaseError(100, errors.New("e1"))
+	e2 := errorx.NewBaseError(101, errors.New("e2"))
+	e2.Append(e1)
+
+	code, ok := errorx.Code(e2)
+	if !ok || code != 101 {
+		t.Fatalf("expected Code(e2) = (101, true), got (%d, %v)", code, ok)
+	}
+}
+
+func TestWalkVisitsNestedErrors(t *testing.T) {
+	e1 := errorx.NewBaseError(100, errors.New("e1"))
+	e2 := errorx.NewBaseError(101, errors.New("e2"))
+	e2.Append(e1)
+	e3 := errorx.NewBaseError(102, errors.New("e3"))
+	e3.Append(e2)
+
+	var codes []int
+	errorx.Walk(e3, func(e errorx.Error) bool {
+		codes = append(codes, e.Code())
+		return true
+	})
+
+	want := []int{102, 101, 100}
+	if len(codes) != len(want) {
+		t.Fatalf("expected codes %v, got %v", want, codes)
+	}
+	for i := range want {
+		if codes[i] != want[i] {
+			t.Fatalf("expected codes %v, got %v", want, codes)
+		}
+	}
+}
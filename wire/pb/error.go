@@ -0,0 +1,366 @@
+// Package pb implements the Go encoding of the errorx.v1 wire schema defined in ../errorx.proto.
+//
+// The encoder/decoder is hand-maintained against google.golang.org/protobuf/encoding/protowire rather
+// than generated by protoc-gen-go, so errorx/wire doesn't pull in a full codegen toolchain for a handful
+// of fields. See the rationale comment at the top of errorx.proto.
+package pb
+
+import "google.golang.org/protobuf/encoding/protowire"
+
+// AttrKind identifies which oneof case of AttrValue is set.
+type AttrKind int32
+
+const (
+	AttrKindUnspecified AttrKind = iota
+	AttrKindString
+	AttrKindInt
+	AttrKindInt64
+	AttrKindUint
+	AttrKindUint64
+	AttrKindBool
+	AttrKindBytes
+	AttrKindDuration
+	AttrKindTime
+)
+
+// AttrValue is the wire representation of errorx.v1.AttrValue.
+type AttrValue struct {
+	Kind          AttrKind
+	String        string
+	Int           int64
+	Uint          uint64
+	Bool          bool
+	Bytes         []byte
+	DurationNanos int64
+	TimeSeconds   int64
+	TimeNanos     int32
+}
+
+// Error is the wire representation of errorx.v1.Error.
+type Error struct {
+	Code    int32
+	Message string
+	Attrs   map[string]*AttrValue
+	File    string
+	Line    int32
+	Method  string
+	Nested  []*Error
+}
+
+// Marshal encodes e using the errorx.v1.Error wire format.
+func (e *Error) Marshal() []byte {
+	var b []byte
+	if e.Code != 0 {
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(e.Code))
+	}
+	if e.Message != "" {
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, e.Message)
+	}
+	for key, val := range e.Attrs {
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		b = protowire.AppendBytes(b, marshalAttrsEntry(key, val))
+	}
+	if e.File != "" {
+		b = protowire.AppendTag(b, 4, protowire.BytesType)
+		b = protowire.AppendString(b, e.File)
+	}
+	if e.Line != 0 {
+		b = protowire.AppendTag(b, 5, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(e.Line))
+	}
+	if e.Method != "" {
+		b = protowire.AppendTag(b, 6, protowire.BytesType)
+		b = protowire.AppendString(b, e.Method)
+	}
+	for _, n := range e.Nested {
+		b = protowire.AppendTag(b, 7, protowire.BytesType)
+		b = protowire.AppendBytes(b, n.Marshal())
+	}
+	return b
+}
+
+// Unmarshal decodes data as an errorx.v1.Error.
+func Unmarshal(data []byte) (*Error, error) {
+	e := &Error{}
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		data = data[n:]
+
+		switch num {
+		case 1:
+			v, m := protowire.ConsumeVarint(data)
+			if m < 0 {
+				return nil, protowire.ParseError(m)
+			}
+			e.Code = int32(v)
+			data = data[m:]
+		case 2:
+			v, m := protowire.ConsumeString(data)
+			if m < 0 {
+				return nil, protowire.ParseError(m)
+			}
+			e.Message = v
+			data = data[m:]
+		case 3:
+			v, m := protowire.ConsumeBytes(data)
+			if m < 0 {
+				return nil, protowire.ParseError(m)
+			}
+			key, val, err := unmarshalAttrsEntry(v)
+			if err != nil {
+				return nil, err
+			}
+			if e.Attrs == nil {
+				e.Attrs = map[string]*AttrValue{}
+			}
+			e.Attrs[key] = val
+			data = data[m:]
+		case 4:
+			v, m := protowire.ConsumeString(data)
+			if m < 0 {
+				return nil, protowire.ParseError(m)
+			}
+			e.File = v
+			data = data[m:]
+		case 5:
+			v, m := protowire.ConsumeVarint(data)
+			if m < 0 {
+				return nil, protowire.ParseError(m)
+			}
+			e.Line = int32(v)
+			data = data[m:]
+		case 6:
+			v, m := protowire.ConsumeString(data)
+			if m < 0 {
+				return nil, protowire.ParseError(m)
+			}
+			e.Method = v
+			data = data[m:]
+		case 7:
+			v, m := protowire.ConsumeBytes(data)
+			if m < 0 {
+				return nil, protowire.ParseError(m)
+			}
+			nested, err := Unmarshal(v)
+			if err != nil {
+				return nil, err
+			}
+			e.Nested = append(e.Nested, nested)
+			data = data[m:]
+		default:
+			m := protowire.ConsumeFieldValue(num, typ, data)
+			if m < 0 {
+				return nil, protowire.ParseError(m)
+			}
+			data = data[m:]
+		}
+	}
+	return e, nil
+}
+
+// marshalAttrsEntry encodes a single map<string, AttrValue> entry (field 1 = key, field 2 = value).
+func marshalAttrsEntry(key string, val *AttrValue) []byte {
+	var b []byte
+	b = protowire.AppendTag(b, 1, protowire.BytesType)
+	b = protowire.AppendString(b, key)
+	b = protowire.AppendTag(b, 2, protowire.BytesType)
+	b = protowire.AppendBytes(b, marshalAttrValue(val))
+	return b
+}
+
+func unmarshalAttrsEntry(data []byte) (string, *AttrValue, error) {
+	var key string
+	val := &AttrValue{}
+	for len(data) > 0 {
+		num, _, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return "", nil, protowire.ParseError(n)
+		}
+		data = data[n:]
+		switch num {
+		case 1:
+			v, m := protowire.ConsumeString(data)
+			if m < 0 {
+				return "", nil, protowire.ParseError(m)
+			}
+			key = v
+			data = data[m:]
+		case 2:
+			v, m := protowire.ConsumeBytes(data)
+			if m < 0 {
+				return "", nil, protowire.ParseError(m)
+			}
+			av, err := unmarshalAttrValue(v)
+			if err != nil {
+				return "", nil, err
+			}
+			val = av
+			data = data[m:]
+		}
+	}
+	return key, val, nil
+}
+
+func marshalAttrValue(av *AttrValue) []byte {
+	var b []byte
+	switch av.Kind {
+	case AttrKindString:
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, av.String)
+	case AttrKindInt:
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(av.Int))
+	case AttrKindInt64:
+		b = protowire.AppendTag(b, 3, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(av.Int))
+	case AttrKindUint:
+		b = protowire.AppendTag(b, 4, protowire.VarintType)
+		b = protowire.AppendVarint(b, av.Uint)
+	case AttrKindUint64:
+		b = protowire.AppendTag(b, 5, protowire.VarintType)
+		b = protowire.AppendVarint(b, av.Uint)
+	case AttrKindBool:
+		v := uint64(0)
+		if av.Bool {
+			v = 1
+		}
+		b = protowire.AppendTag(b, 6, protowire.VarintType)
+		b = protowire.AppendVarint(b, v)
+	case AttrKindBytes:
+		b = protowire.AppendTag(b, 7, protowire.BytesType)
+		b = protowire.AppendBytes(b, av.Bytes)
+	case AttrKindDuration:
+		b = protowire.AppendTag(b, 8, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(av.DurationNanos))
+	case AttrKindTime:
+		b = protowire.AppendTag(b, 9, protowire.BytesType)
+		b = protowire.AppendBytes(b, marshalTimestamp(av.TimeSeconds, av.TimeNanos))
+	}
+	return b
+}
+
+func unmarshalAttrValue(data []byte) (*AttrValue, error) {
+	av := &AttrValue{}
+	for len(data) > 0 {
+		num, _, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		data = data[n:]
+		switch num {
+		case 1:
+			v, m := protowire.ConsumeString(data)
+			if m < 0 {
+				return nil, protowire.ParseError(m)
+			}
+			av.Kind, av.String = AttrKindString, v
+			data = data[m:]
+		case 2:
+			v, m := protowire.ConsumeVarint(data)
+			if m < 0 {
+				return nil, protowire.ParseError(m)
+			}
+			av.Kind, av.Int = AttrKindInt, int64(v)
+			data = data[m:]
+		case 3:
+			v, m := protowire.ConsumeVarint(data)
+			if m < 0 {
+				return nil, protowire.ParseError(m)
+			}
+			av.Kind, av.Int = AttrKindInt64, int64(v)
+			data = data[m:]
+		case 4:
+			v, m := protowire.ConsumeVarint(data)
+			if m < 0 {
+				return nil, protowire.ParseError(m)
+			}
+			av.Kind, av.Uint = AttrKindUint, v
+			data = data[m:]
+		case 5:
+			v, m := protowire.ConsumeVarint(data)
+			if m < 0 {
+				return nil, protowire.ParseError(m)
+			}
+			av.Kind, av.Uint = AttrKindUint64, v
+			data = data[m:]
+		case 6:
+			v, m := protowire.ConsumeVarint(data)
+			if m < 0 {
+				return nil, protowire.ParseError(m)
+			}
+			av.Kind, av.Bool = AttrKindBool, v != 0
+			data = data[m:]
+		case 7:
+			v, m := protowire.ConsumeBytes(data)
+			if m < 0 {
+				return nil, protowire.ParseError(m)
+			}
+			av.Kind, av.Bytes = AttrKindBytes, v
+			data = data[m:]
+		case 8:
+			v, m := protowire.ConsumeVarint(data)
+			if m < 0 {
+				return nil, protowire.ParseError(m)
+			}
+			av.Kind, av.DurationNanos = AttrKindDuration, int64(v)
+			data = data[m:]
+		case 9:
+			v, m := protowire.ConsumeBytes(data)
+			if m < 0 {
+				return nil, protowire.ParseError(m)
+			}
+			seconds, nanos, err := unmarshalTimestamp(v)
+			if err != nil {
+				return nil, err
+			}
+			av.Kind, av.TimeSeconds, av.TimeNanos = AttrKindTime, seconds, nanos
+			data = data[m:]
+		}
+	}
+	return av, nil
+}
+
+func marshalTimestamp(seconds int64, nanos int32) []byte {
+	var b []byte
+	if seconds != 0 {
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(seconds))
+	}
+	if nanos != 0 {
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(nanos))
+	}
+	return b
+}
+
+func unmarshalTimestamp(data []byte) (seconds int64, nanos int32, err error) {
+	for len(data) > 0 {
+		num, _, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return 0, 0, protowire.ParseError(n)
+		}
+		data = data[n:]
+		switch num {
+		case 1:
+			v, m := protowire.ConsumeVarint(data)
+			if m < 0 {
+				return 0, 0, protowire.ParseError(m)
+			}
+			seconds = int64(v)
+			data = data[m:]
+		case 2:
+			v, m := protowire.ConsumeVarint(data)
+			if m < 0 {
+				return 0, 0, protowire.ParseError(m)
+			}
+			nanos = int32(v)
+			data = data[m:]
+		}
+	}
+	return seconds, nanos, nil
+}
@@ -0,0 +1,43 @@
+package pb_test
+
+import (
+	"testing"
+
+	"go.joshhogle.dev/errorx/wire/pb"
+)
+
+func TestErrorMarshalUnmarshalRoundTrip(t *testing.T) {
+	in := &pb.Error{
+		Code:    102,
+		Message: "outer failure",
+		Attrs: map[string]*pb.AttrValue{
+			"user_id": {Kind: pb.AttrKindString, String: "u-123"},
+			"retries": {Kind: pb.AttrKindInt, Int: 3},
+		},
+		File:   "main.go",
+		Line:   42,
+		Method: "main.doWork",
+		Nested: []*pb.Error{
+			{Code: 101, Message: "inner failure"},
+		},
+	}
+
+	out, err := pb.Unmarshal(in.Marshal())
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	if out.Code != in.Code || out.Message != in.Message || out.File != in.File || out.Line != in.Line ||
+		out.Method != in.Method {
+		t.Fatalf("scalar fields did not round-trip: got %+v", out)
+	}
+	if len(out.Nested) != 1 || out.Nested[0].Code != 101 {
+		t.Fatalf("nested errors did not round-trip: got %+v", out.Nested)
+	}
+	if av := out.Attrs["user_id"]; av == nil || av.Kind != pb.AttrKindString || av.String != "u-123" {
+		t.Fatalf("string attribute did not round-trip: got %+v", av)
+	}
+	if av := out.Attrs["retries"]; av == nil || av.Kind != pb.AttrKindInt || av.Int != 3 {
+		t.Fatalf("int attribute did not round-trip: got %+v", av)
+	}
+}
@@ -0,0 +1,167 @@
+// Package wire converts errorx errors to and from the forms needed to cross a process boundary: the
+// errorx.v1 protobuf message defined in errorx.proto, and a gRPC status detail built from it. Use
+// BaseError's MarshalJSON/UnmarshalJSON (in the root package) for the JSON wire format.
+package wire
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"go.joshhogle.dev/errorx"
+	"go.joshhogle.dev/errorx/wire/pb"
+)
+
+// typeURL identifies an errorx.v1.Error packed into a google.protobuf.Any, e.g. as a gRPC status detail.
+const typeURL = "type.googleapis.com/errorx.v1.Error"
+
+// ToProto converts err into its protobuf representation, recursing into NestedErrors().
+//
+// Message carries InternalError()'s raw text rather than Error()'s formatted output: Error() on a
+// reconstructed error already re-derives its formatted form from the internal error, so wiring through
+// the formatted string would compound "error: " prefixes on every hop.
+func ToProto(err errorx.Error) (*pb.Error, error) {
+	out := &pb.Error{
+		Code:    int32(err.Code()),
+		Message: err.InternalError().Error(),
+		File:    err.File(),
+		Line:    int32(err.Line()),
+		Method:  err.Method(),
+	}
+	if attrs := err.Attrs(); len(attrs) > 0 {
+		out.Attrs = make(map[string]*pb.AttrValue, len(attrs))
+		for k, v := range attrs {
+			av, convErr := toProtoAttr(v)
+			if convErr != nil {
+				return nil, fmt.Errorf("attribute %q: %w", k, convErr)
+			}
+			out.Attrs[k] = av
+		}
+	}
+	for _, n := range err.NestedErrors() {
+		nested, convErr := ToProto(n)
+		if convErr != nil {
+			return nil, convErr
+		}
+		out.Nested = append(out.Nested, nested)
+	}
+	return out, nil
+}
+
+// FromProto reverses ToProto, reconstructing an errorx.Error via errorx.NewRehydratedBaseError.
+func FromProto(in *pb.Error) (errorx.Error, error) {
+	attrs := make(map[string]any, len(in.Attrs))
+	for k, av := range in.Attrs {
+		v, err := fromProtoAttr(av)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %q: %w", k, err)
+		}
+		attrs[k] = v
+	}
+	nested := make([]errorx.Error, 0, len(in.Nested))
+	for _, n := range in.Nested {
+		ne, err := FromProto(n)
+		if err != nil {
+			return nil, err
+		}
+		nested = append(nested, ne)
+	}
+	var frame *errorx.StackFrame
+	if in.File != "" || in.Method != "" || in.Line != 0 {
+		frame = &errorx.StackFrame{File: in.File, Line: int(in.Line), Function: in.Method}
+	}
+	return errorx.NewRehydratedBaseError(int(in.Code), errors.New(in.Message), errorx.SeverityError, frame,
+		attrs, nested), nil
+}
+
+// ToGRPCStatus packs err's protobuf representation as a detail on a gRPC status with the given code.
+func ToGRPCStatus(err errorx.Error, code codes.Code) (*grpcstatus.Status, error) {
+	pbErr, convErr := ToProto(err)
+	if convErr != nil {
+		return nil, convErr
+	}
+	detail := &anypb.Any{TypeUrl: typeURL, Value: pbErr.Marshal()}
+	return grpcstatus.FromProto(&status.Status{
+		Code:    int32(code),
+		Message: err.Error(),
+		Details: []*anypb.Any{detail},
+	}), nil
+}
+
+// FromGRPCStatus looks for an errorx.v1.Error detail on s and, if found, reconstructs it via FromProto.
+// It returns false if s carries no such detail or the detail cannot be decoded.
+func FromGRPCStatus(s *grpcstatus.Status) (errorx.Error, bool) {
+	for _, detail := range s.Proto().GetDetails() {
+		if detail.GetTypeUrl() != typeURL {
+			continue
+		}
+		pbErr, err := pb.Unmarshal(detail.GetValue())
+		if err != nil {
+			return nil, false
+		}
+		e, err := FromProto(pbErr)
+		if err != nil {
+			return nil, false
+		}
+		return e, true
+	}
+	return nil, false
+}
+
+// toProtoAttr converts an attribute value into its typed protobuf representation, matching the typed
+// AttrX accessors on BaseError.
+func toProtoAttr(v any) (*pb.AttrValue, error) {
+	switch val := v.(type) {
+	case string:
+		return &pb.AttrValue{Kind: pb.AttrKindString, String: val}, nil
+	case int:
+		return &pb.AttrValue{Kind: pb.AttrKindInt, Int: int64(val)}, nil
+	case int64:
+		return &pb.AttrValue{Kind: pb.AttrKindInt64, Int: val}, nil
+	case uint:
+		return &pb.AttrValue{Kind: pb.AttrKindUint, Uint: uint64(val)}, nil
+	case uint64:
+		return &pb.AttrValue{Kind: pb.AttrKindUint64, Uint: val}, nil
+	case bool:
+		return &pb.AttrValue{Kind: pb.AttrKindBool, Bool: val}, nil
+	case []byte:
+		return &pb.AttrValue{Kind: pb.AttrKindBytes, Bytes: val}, nil
+	case time.Duration:
+		return &pb.AttrValue{Kind: pb.AttrKindDuration, DurationNanos: int64(val)}, nil
+	case time.Time:
+		return &pb.AttrValue{Kind: pb.AttrKindTime, TimeSeconds: val.Unix(), TimeNanos: int32(val.Nanosecond())}, nil
+	default:
+		return nil, fmt.Errorf("errorx/wire: attribute of type %T cannot be converted to protobuf", v)
+	}
+}
+
+// fromProtoAttr reverses toProtoAttr.
+func fromProtoAttr(av *pb.AttrValue) (any, error) {
+	switch av.Kind {
+	case pb.AttrKindString:
+		return av.String, nil
+	case pb.AttrKindInt:
+		return int(av.Int), nil
+	case pb.AttrKindInt64:
+		return av.Int, nil
+	case pb.AttrKindUint:
+		return uint(av.Uint), nil
+	case pb.AttrKindUint64:
+		return av.Uint, nil
+	case pb.AttrKindBool:
+		return av.Bool, nil
+	case pb.AttrKindBytes:
+		return av.Bytes, nil
+	case pb.AttrKindDuration:
+		return time.Duration(av.DurationNanos), nil
+	case pb.AttrKindTime:
+		return time.Unix(av.TimeSeconds, int64(av.TimeNanos)).UTC(), nil
+	default:
+		return nil, fmt.Errorf("errorx/wire: unknown attribute kind %d", av.Kind)
+	}
+}
@@ -0,0 +1,62 @@
+package wire_test
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"go.joshhogle.dev/errorx"
+	"go.joshhogle.dev/errorx/wire"
+)
+
+func TestToProtoFromProtoRoundTrip(t *testing.T) {
+	e1 := errorx.NewBaseError(100, errors.New("inner"))
+	e2 := errorx.NewBaseError(101, errors.New("outer"))
+	e2.Append(e1)
+	e2.WithAttr("user_id", "u-123")
+
+	pbErr, err := wire.ToProto(e2)
+	if err != nil {
+		t.Fatalf("ToProto failed: %v", err)
+	}
+
+	out, err := wire.FromProto(pbErr)
+	if err != nil {
+		t.Fatalf("FromProto failed: %v", err)
+	}
+
+	if out.Code() != e2.Code() {
+		t.Fatalf("expected code %d, got %d", e2.Code(), out.Code())
+	}
+	if out.Error() != e2.Error() {
+		t.Fatalf("expected Error() %q, got %q", e2.Error(), out.Error())
+	}
+	if len(out.NestedErrors()) != 1 || out.NestedErrors()[0].Code() != e1.Code() {
+		t.Fatalf("expected 1 nested error with code %d, got %+v", e1.Code(), out.NestedErrors())
+	}
+}
+
+func TestGRPCStatusRoundTrip(t *testing.T) {
+	e1 := errorx.NewBaseError(404, errors.New("not found"))
+	e1.WithAttr("resource", "widget-42")
+
+	st, err := wire.ToGRPCStatus(e1, codes.NotFound)
+	if err != nil {
+		t.Fatalf("ToGRPCStatus failed: %v", err)
+	}
+	if st.Code() != codes.NotFound {
+		t.Fatalf("expected code %v, got %v", codes.NotFound, st.Code())
+	}
+
+	out, ok := wire.FromGRPCStatus(st)
+	if !ok {
+		t.Fatalf("expected FromGRPCStatus to find an errorx detail")
+	}
+	if out.Code() != e1.Code() {
+		t.Fatalf("expected code %d, got %d", e1.Code(), out.Code())
+	}
+	if out.Error() != e1.Error() {
+		t.Fatalf("expected Error() %q, got %q", e1.Error(), out.Error())
+	}
+}